@@ -8,10 +8,52 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 type Configor struct {
 	*Config
+
+	// loadFiles remembers the file arguments passed to the most recent call
+	// to Load, so WatchConfig can resolve and re-resolve the overlay chain
+	// (base, environment overlay and example variants) without requiring
+	// callers to repeat themselves.
+	loadFiles []string
+
+	boundEnvMu sync.RWMutex
+	boundEnv   map[string][]string
+
+	// sources are remote Sources registered via AddSource. They are read
+	// in Load, in the order added, after the local files and before
+	// env-var/default/required processing.
+	sourcesMu sync.RWMutex
+	sources   []Source
+
+	validatorsMu sync.RWMutex
+	validators   map[string]ValidatorFunc
+}
+
+// BindEnv registers additional env var names to probe for the field at
+// fieldPath (a dotted path of struct field names, e.g. "Database.Host"),
+// without requiring an `env` struct tag. Bound names are probed, in the
+// order given, before the names derived from the `env` tag or the
+// auto-generated Prefix_Field/PREFIX_FIELD forms, which lets callers
+// migrate a field to a new env var name while still falling back to the
+// old ones.
+func (c *Configor) BindEnv(fieldPath string, names ...string) {
+	c.boundEnvMu.Lock()
+	defer c.boundEnvMu.Unlock()
+
+	if c.boundEnv == nil {
+		c.boundEnv = map[string][]string{}
+	}
+	c.boundEnv[fieldPath] = names
+}
+
+func (c *Configor) getBoundEnv(fieldPath string) []string {
+	c.boundEnvMu.RLock()
+	defer c.boundEnvMu.RUnlock()
+	return c.boundEnv[fieldPath]
 }
 
 type Config struct {
@@ -74,19 +116,39 @@ func (c *Configor) Load(config interface{}, files ...string) (err error) {
 		return fmt.Errorf("Config %v should be addressable", config)
 	}
 
+	c.loadFiles = files
+
 	configFiles := c.getConfigurationFiles(files...)
 
 	for _, file := range configFiles {
+		if isURL(file) {
+			if err := c.loadSource(config, NewHTTPSource(file)); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := UnmarshalFile(config, file, c.ErrorOnUnmatchedKeys); err != nil {
 			return err
 		}
 	}
 
+	for _, source := range c.getSources() {
+		if err := c.loadSource(config, source); err != nil {
+			return err
+		}
+	}
+
 	prefix := c.GetEnvironmentPrefix()
 	if prefix == "" {
-		return c.processTags(config)
+		err = c.processTags(config)
+	} else {
+		err = c.processTags(config, prefix)
 	}
-	return c.processTags(config, prefix)
+	if err != nil {
+		return err
+	}
+
+	return c.Validate(config)
 }
 
 // UnmatchedTomlKeysError errors are returned by the Load function when
@@ -126,6 +188,13 @@ func (c *Configor) getConfigurationFiles(files ...string) []string {
 		foundFile := false
 		file := files[i]
 
+		// a remote source (e.g. "http://config/app.yaml") is resolved by
+		// Load via a Source rather than the local filesystem overlay
+		if isURL(file) {
+			results = append(results, file)
+			continue
+		}
+
 		// check configuration
 		if fileInfo, err := os.Stat(file); err == nil && fileInfo.Mode().IsRegular() {
 			foundFile = true
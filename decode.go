@@ -9,35 +9,27 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"reflect"
 	"regexp"
 	"strings"
 )
 
-// UnmarshalFile attempts to decode the given file as a yaml, toml or json based
-// on the file extension. If the extension isn't one of those, it will error.
+// UnmarshalFile reads the given file and decodes it into config using the
+// Decoder registered for its extension. See RegisterDecoder for adding
+// support for additional formats; yaml, toml, json, .env, .properties and
+// hcl are registered by default.
 func UnmarshalFile(config interface{}, file string, errorOnUnmatchedKeys bool) (err error) {
 	data, err := ioutil.ReadFile(file)
 	if err != nil {
 		return err
 	}
 
-	switch {
-	case strings.HasSuffix(file, ".yaml") || strings.HasSuffix(file, ".yml"):
-		if errorOnUnmatchedKeys {
-			return yaml.UnmarshalStrict(data, config)
-		}
-		return yaml.Unmarshal(data, config)
-
-	case strings.HasSuffix(file, ".toml"):
-		return unmarshalToml(data, config, errorOnUnmatchedKeys)
-
-	case strings.HasSuffix(file, ".json"):
-		return unmarshalJSON(data, config, errorOnUnmatchedKeys)
-
-	default:
+	decoder, ok := getDecoder(path.Ext(file))
+	if !ok {
 		return errors.New("not a yaml, json or toml file")
 	}
+	return decoder.Decode(data, config, errorOnUnmatchedKeys)
 }
 
 // GetStringTomlKeys returns a string array of the names of the keys that are passed in as args
@@ -77,6 +69,13 @@ func unmarshalJSON(data []byte, config interface{}, errorOnUnmatchedKeys bool) e
 }
 
 func (c *Configor) processTags(config interface{}, prefixes ...string) error {
+	return c.processTagsPath(config, "", prefixes...)
+}
+
+// processTagsPath is processTags with an additional dotted field path
+// (e.g. "Database.Host") threaded through the recursion, used to look up
+// names registered via BindEnv independently of the env-var prefix chain.
+func (c *Configor) processTagsPath(config interface{}, fieldPath string, prefixes ...string) error {
 	configValue := reflect.Indirect(reflect.ValueOf(config))
 
 	// if the field is a pointer, keep dereferencing it until it's a struct
@@ -101,11 +100,23 @@ func (c *Configor) processTags(config interface{}, prefixes ...string) error {
 			continue
 		}
 
+		currentPath := fieldStruct.Name
+		if fieldPath != "" {
+			currentPath = fieldPath + "." + currentPath
+		}
+
+		// Precedence, first non-empty wins: names bound at runtime via
+		// BindEnv, then the comma-separated `env` tag list in order, then
+		// the auto-generated Prefix_Field and PREFIX_FIELD forms.
+		envNames = append(envNames, c.getBoundEnv(currentPath)...)
 		if envName == "" {
-			envNames = append(envNames, strings.Join(append(prefixes, fieldStruct.Name), "_"))                       // Configor_DB_Name
-			envNames = append(envNames, toScreamingSnakeCase(strings.Join(append(prefixes, fieldStruct.Name), "_"))) // CONFIGOR_DB_NAME
+			autoName := strings.Join(append(prefixes, fieldStruct.Name), "_")
+			envNames = append(envNames, autoName)                       // Configor_DB_Name
+			envNames = append(envNames, toScreamingSnakeCase(autoName)) // CONFIGOR_DB_NAME
 		} else {
-			envNames = []string{envName}
+			for _, name := range strings.Split(envName, ",") {
+				envNames = append(envNames, strings.TrimSpace(name))
+			}
 		}
 
 		// Load From Shell ENV
@@ -135,7 +146,7 @@ func (c *Configor) processTags(config interface{}, prefixes ...string) error {
 		}
 
 		if field.Kind() == reflect.Struct {
-			if err := c.processTags(field.Addr().Interface(), prefix(prefixes, &fieldStruct)...); err != nil {
+			if err := c.processTagsPath(field.Addr().Interface(), currentPath, prefix(prefixes, &fieldStruct)...); err != nil {
 				return err
 			}
 		}
@@ -143,8 +154,9 @@ func (c *Configor) processTags(config interface{}, prefixes ...string) error {
 		if field.Kind() == reflect.Slice {
 			for i := 0; i < field.Len(); i++ {
 				if reflect.Indirect(field.Index(i)).Kind() == reflect.Struct {
-					err := c.processTags(
+					err := c.processTagsPath(
 						field.Index(i).Addr().Interface(),
+						fmt.Sprintf("%v.%v", currentPath, i),
 						append(prefix(prefixes, &fieldStruct), fmt.Sprint(i))...,
 					)
 					if err != nil {
@@ -0,0 +1,247 @@
+package configor
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl"
+	"gopkg.in/yaml.v2"
+)
+
+// Decoder decodes raw file contents into config. errorOnUnmatchedKeys
+// requests that the decoder return an error if the data contains keys with
+// no matching field in config, where the underlying format supports that
+// check.
+type Decoder interface {
+	Decode(data []byte, config interface{}, errorOnUnmatchedKeys bool) error
+}
+
+// DecoderFunc adapts a plain function to a Decoder.
+type DecoderFunc func(data []byte, config interface{}, errorOnUnmatchedKeys bool) error
+
+func (f DecoderFunc) Decode(data []byte, config interface{}, errorOnUnmatchedKeys bool) error {
+	return f(data, config, errorOnUnmatchedKeys)
+}
+
+var (
+	decodersMu sync.RWMutex
+	decoders   = map[string]Decoder{
+		".yaml":       DecoderFunc(decodeYAML),
+		".yml":        DecoderFunc(decodeYAML),
+		".toml":       DecoderFunc(unmarshalToml),
+		".json":       DecoderFunc(unmarshalJSON),
+		".env":        DecoderFunc(decodeDotenv),
+		".properties": DecoderFunc(decodeProperties),
+		".hcl":        DecoderFunc(decodeHCL),
+	}
+)
+
+// RegisterDecoder registers a Decoder for files with the given extension
+// (including the leading dot, e.g. ".ini"), overriding any decoder already
+// registered for it. It is safe to call from multiple goroutines, but
+// should generally be called from an init function before Load is used.
+func RegisterDecoder(ext string, d Decoder) {
+	decodersMu.Lock()
+	defer decodersMu.Unlock()
+	decoders[ext] = d
+}
+
+func getDecoder(ext string) (Decoder, bool) {
+	decodersMu.RLock()
+	defer decodersMu.RUnlock()
+	d, ok := decoders[ext]
+	return d, ok
+}
+
+func decodeYAML(data []byte, config interface{}, errorOnUnmatchedKeys bool) error {
+	if errorOnUnmatchedKeys {
+		return yaml.UnmarshalStrict(data, config)
+	}
+	return yaml.Unmarshal(data, config)
+}
+
+func decodeHCL(data []byte, config interface{}, errorOnUnmatchedKeys bool) error {
+	return hcl.Unmarshal(data, config)
+}
+
+// decodeDotenv parses KEY=VALUE lines (dotenv style) and merges them into
+// config using the same field/prefix resolution processTags uses for
+// shell env vars, so a flat DB_HOST=localhost line fills a nested DB.Host
+// field the same way the DB_HOST environment variable would.
+func decodeDotenv(data []byte, config interface{}, errorOnUnmatchedKeys bool) error {
+	kv, err := parseDotenv(data)
+	if err != nil {
+		return err
+	}
+	return populateFromKV(config, normalizeKV(kv))
+}
+
+func parseDotenv(data []byte) (map[string]string, error) {
+	kv := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = unquoteDotenvValue(strings.TrimSpace(value))
+		value = expandDotenvVars(value, kv)
+		kv[key] = value
+	}
+	return kv, scanner.Err()
+}
+
+func unquoteDotenvValue(value string) string {
+	if len(value) >= 2 {
+		if (value[0] == '"' && value[len(value)-1] == '"') || (value[0] == '\'' && value[len(value)-1] == '\'') {
+			return value[1 : len(value)-1]
+		}
+	}
+	if idx := strings.Index(value, " #"); idx >= 0 {
+		value = strings.TrimSpace(value[:idx])
+	}
+	return value
+}
+
+// expandDotenvVars resolves ${VAR} references against keys already parsed
+// from the same file, falling back to the process environment.
+func expandDotenvVars(value string, kv map[string]string) string {
+	return os.Expand(value, func(name string) string {
+		if v, ok := kv[name]; ok {
+			return v
+		}
+		return os.Getenv(name)
+	})
+}
+
+// decodeProperties parses Java-style .properties files (key=value or
+// key: value, '#' or '!' comments, backslash line continuations) and
+// merges them into config the same way decodeDotenv does, translating
+// dotted keys such as "db.host" to the "Db_Host"/"DB_HOST" naming that
+// processTags generates for nested structs.
+func decodeProperties(data []byte, config interface{}, errorOnUnmatchedKeys bool) error {
+	kv, err := parseProperties(data)
+	if err != nil {
+		return err
+	}
+	return populateFromKV(config, normalizeKV(kv))
+}
+
+// normalizeKV uppercases every key so populateFromKV can match it against
+// both the PREFIX_FIELD auto-generated name and the mixed-case Prefix_Field
+// one regardless of how the source format happened to case its keys (.env
+// conventionally already uses SCREAMING_SNAKE, but Java .properties files
+// are conventionally lower.dotted, e.g. "db.host").
+func normalizeKV(kv map[string]string) map[string]string {
+	normalized := make(map[string]string, len(kv))
+	for key, value := range kv {
+		normalized[strings.ToUpper(key)] = value
+	}
+	return normalized
+}
+
+func parseProperties(data []byte) (map[string]string, error) {
+	kv := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	var pending string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if pending != "" {
+			line = pending + strings.TrimLeft(line, " \t")
+			pending = ""
+		} else {
+			trimmed := strings.TrimSpace(line)
+			if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "!") {
+				continue
+			}
+			line = trimmed
+		}
+
+		if strings.HasSuffix(line, "\\") {
+			pending = strings.TrimSuffix(line, "\\")
+			continue
+		}
+
+		key, value, ok := splitPropertiesLine(line)
+		if !ok {
+			continue
+		}
+		kv[strings.ReplaceAll(key, ".", "_")] = value
+	}
+	return kv, scanner.Err()
+}
+
+func splitPropertiesLine(line string) (key, value string, ok bool) {
+	idx := strings.IndexAny(line, "=:")
+	if idx < 0 {
+		return "", "", false
+	}
+	return strings.TrimSpace(line[:idx]), strings.TrimSpace(line[idx+1:]), true
+}
+
+// populateFromKV sets fields on config from kv the same way processTags
+// reads shell env vars: an explicit `env` tag takes the field's literal
+// key, otherwise the Prefix_Field and PREFIX_FIELD forms are both tried.
+// kv must already be normalized by normalizeKV (uppercased keys); lookups
+// here uppercase each candidate name to match it case-insensitively.
+func populateFromKV(config interface{}, kv map[string]string, prefixes ...string) error {
+	configValue := reflect.Indirect(reflect.ValueOf(config))
+	for configValue.Kind() == reflect.Ptr {
+		configValue = configValue.Elem()
+	}
+
+	if configValue.Kind() != reflect.Struct {
+		return fmt.Errorf("expected struct but got %v", configValue.Kind().String())
+	}
+
+	configType := configValue.Type()
+	for i := 0; i < configType.NumField(); i++ {
+		fieldStruct := configType.Field(i)
+		field := configValue.Field(i)
+
+		if !field.CanAddr() || !field.CanInterface() {
+			continue
+		}
+
+		names := []string{strings.Join(append(prefixes, fieldStruct.Name), "_")}
+		if envName := fieldStruct.Tag.Get("env"); envName != "" {
+			names = strings.Split(envName, ",")
+		} else {
+			names = append(names, toScreamingSnakeCase(names[0]))
+		}
+
+		for _, name := range names {
+			if value, ok := kv[strings.ToUpper(strings.TrimSpace(name))]; ok {
+				if err := yaml.Unmarshal([]byte(value), field.Addr().Interface()); err != nil {
+					return err
+				}
+				break
+			}
+		}
+
+		for field.Kind() == reflect.Ptr {
+			field = field.Elem()
+		}
+
+		if field.Kind() == reflect.Struct {
+			if err := populateFromKV(field.Addr().Interface(), kv, prefix(prefixes, &fieldStruct)...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
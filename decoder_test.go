@@ -0,0 +1,62 @@
+package configor
+
+import "testing"
+
+type decoderTestConfig struct {
+	Name string
+	DB   struct {
+		Host string
+		Port int
+	}
+}
+
+// TestDecodeProperties_LowercaseDottedKeys exercises the standard Java
+// .properties convention (lowercase, dot-separated keys) rather than keys
+// that happen to already match the Go field names, which previously
+// decoded into a zero-value struct because the lookup was case-sensitive.
+func TestDecodeProperties_LowercaseDottedKeys(t *testing.T) {
+	data := []byte("name: hello\ndb.host=localhost\ndb.port=5432\n")
+
+	var cfg decoderTestConfig
+	if err := decodeProperties(data, &cfg, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Name != "hello" || cfg.DB.Host != "localhost" || cfg.DB.Port != 5432 {
+		t.Fatalf("unexpected config after decoding .properties: %+v", cfg)
+	}
+}
+
+func TestDecodeHCL_NestedBlock(t *testing.T) {
+	data := []byte(`
+name = "hello"
+db {
+  host = "localhost"
+  port = 5432
+}
+`)
+
+	var cfg decoderTestConfig
+	if err := decodeHCL(data, &cfg, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Name != "hello" || cfg.DB.Host != "localhost" || cfg.DB.Port != 5432 {
+		t.Fatalf("unexpected config after decoding .hcl: %+v", cfg)
+	}
+}
+
+func TestDecodeDotenv_BasicAndExpansion(t *testing.T) {
+	data := []byte("NAME=hello\nDB_HOST=localhost\nDB_PORT=${PORT_NUM}\n")
+
+	var cfg decoderTestConfig
+	t.Setenv("PORT_NUM", "5432")
+
+	if err := decodeDotenv(data, &cfg, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Name != "hello" || cfg.DB.Host != "localhost" || cfg.DB.Port != 5432 {
+		t.Fatalf("unexpected config after decoding .env: %+v", cfg)
+	}
+}
@@ -0,0 +1,40 @@
+package configor
+
+import "testing"
+
+type envTestConfig struct {
+	DatabaseURL string `env:"DATABASE_URL,DB_URL,POSTGRES_URL"`
+	Name        string
+}
+
+func TestProcessTags_MultiEnvFallback(t *testing.T) {
+	t.Setenv("DB_URL", "postgres://fallback")
+	t.Setenv("POSTGRES_URL", "postgres://last")
+
+	var cfg envTestConfig
+	c := New(nil)
+	if err := c.processTags(&cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.DatabaseURL != "postgres://fallback" {
+		t.Fatalf("expected the first non-empty name in the list to win, got %q", cfg.DatabaseURL)
+	}
+}
+
+func TestProcessTags_BindEnvTakesPrecedence(t *testing.T) {
+	t.Setenv("NAME", "from-auto-name")
+	t.Setenv("BOUND_NAME", "from-bind-env")
+
+	var cfg envTestConfig
+	c := New(nil)
+	c.BindEnv("Name", "BOUND_NAME")
+
+	if err := c.processTags(&cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Name != "from-bind-env" {
+		t.Fatalf("expected BindEnv name to take precedence over the auto-generated name, got %q", cfg.Name)
+	}
+}
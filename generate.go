@@ -0,0 +1,152 @@
+package configor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// GenerateEnvExample walks config with the same reflect logic processTags
+// uses to resolve env var names, and writes a ".env.example"-style
+// listing to w: one KEY=default line per leaf field, respecting
+// EnvironmentPrefix, `env` tags and nested Prefix_Sub_Field composition,
+// with a "# required" comment above any field tagged `required:"true"`
+// that has no default.
+func (c *Configor) GenerateEnvExample(config interface{}, w io.Writer) error {
+	var prefixes []string
+	if p := c.GetEnvironmentPrefix(); p != "" {
+		prefixes = append(prefixes, p)
+	}
+	return c.writeEnvExample(config, w, prefixes...)
+}
+
+func (c *Configor) writeEnvExample(config interface{}, w io.Writer, prefixes ...string) error {
+	configValue := reflect.Indirect(reflect.ValueOf(config))
+	for configValue.Kind() == reflect.Ptr {
+		configValue = configValue.Elem()
+	}
+
+	if configValue.Kind() != reflect.Struct {
+		return fmt.Errorf("expected struct but got %v", configValue.Kind().String())
+	}
+
+	configType := configValue.Type()
+	for i := 0; i < configType.NumField(); i++ {
+		fieldStruct := configType.Field(i)
+		field := configValue.Field(i)
+
+		if !field.CanAddr() || !field.CanInterface() {
+			continue
+		}
+
+		for field.Kind() == reflect.Ptr {
+			field = field.Elem()
+		}
+
+		if field.Kind() == reflect.Struct {
+			if err := c.writeEnvExample(field.Addr().Interface(), w, prefix(prefixes, &fieldStruct)...); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name := toScreamingSnakeCase(strings.Join(append(prefixes, fieldStruct.Name), "_"))
+		if envName := fieldStruct.Tag.Get("env"); envName != "" {
+			name = strings.TrimSpace(strings.SplitN(envName, ",", 2)[0])
+		}
+
+		def := fieldStruct.Tag.Get("default")
+		if fieldStruct.Tag.Get("required") == "true" && def == "" {
+			if _, err := fmt.Fprintf(w, "# required\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%v=%v\n", name, def); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GenerateExampleFile walks config the same way GenerateEnvExample does,
+// building a copy populated with every field's `default` tag value (zero
+// value where there is none), and writes it to w encoded as format
+// ("yaml", "toml" or "json").
+func (c *Configor) GenerateExampleFile(config interface{}, format string, w io.Writer) error {
+	example := reflect.New(reflect.Indirect(reflect.ValueOf(config)).Type())
+	if err := applyDefaults(example.Interface()); err != nil {
+		return err
+	}
+
+	switch format {
+	case "yaml", "yml":
+		data, err := yaml.Marshal(example.Interface())
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+
+	case "json":
+		data, err := json.MarshalIndent(example.Interface(), "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+
+	case "toml":
+		return toml.NewEncoder(w).Encode(example.Interface())
+
+	default:
+		return fmt.Errorf("configor: unsupported example format %q", format)
+	}
+}
+
+// applyDefaults recursively sets every field's `default` tag value,
+// leaving fields without one at their zero value. It is the defaulting
+// half of processTags without the env-var lookups or required checks,
+// reused here so GenerateExampleFile's output matches what Load would
+// produce for an otherwise-empty environment.
+func applyDefaults(config interface{}) error {
+	configValue := reflect.Indirect(reflect.ValueOf(config))
+	for configValue.Kind() == reflect.Ptr {
+		configValue = configValue.Elem()
+	}
+
+	if configValue.Kind() != reflect.Struct {
+		return fmt.Errorf("expected struct but got %v", configValue.Kind().String())
+	}
+
+	configType := configValue.Type()
+	for i := 0; i < configType.NumField(); i++ {
+		fieldStruct := configType.Field(i)
+		field := configValue.Field(i)
+
+		if !field.CanAddr() || !field.CanInterface() {
+			continue
+		}
+
+		if value := fieldStruct.Tag.Get("default"); value != "" {
+			if err := yaml.Unmarshal([]byte(value), field.Addr().Interface()); err != nil {
+				return err
+			}
+		}
+
+		for field.Kind() == reflect.Ptr {
+			field = field.Elem()
+		}
+
+		if field.Kind() == reflect.Struct {
+			if err := applyDefaults(field.Addr().Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package configor
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type generateTestConfig struct {
+	Name string `default:"app"`
+	DB   struct {
+		Host string `required:"true"`
+		Port int    `default:"5432"`
+	}
+}
+
+func TestGenerateEnvExample(t *testing.T) {
+	var buf bytes.Buffer
+	c := New(&Config{EnvironmentPrefix: "APP"})
+	if err := c.GenerateEnvExample(&generateTestConfig{}, &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"APP_NAME=app", "APP_DB_HOST=", "APP_DB_PORT=5432", "# required"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected output to contain %q, got:\n%v", want, out)
+		}
+	}
+}
+
+func TestGenerateExampleFile_YAML(t *testing.T) {
+	var buf bytes.Buffer
+	c := New(nil)
+	if err := c.GenerateExampleFile(&generateTestConfig{}, "yaml", &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var cfg generateTestConfig
+	if err := decodeYAML(buf.Bytes(), &cfg, false); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Name != "app" || cfg.DB.Port != 5432 {
+		t.Fatalf("unexpected example file contents: %+v", cfg)
+	}
+}
@@ -0,0 +1,233 @@
+package configor
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// Source is a remote configuration provider: etcd, Consul, an HTTP
+// endpoint, or anything else that can produce raw configuration bytes.
+// Sources participate in the same overlay chain as the files resolved by
+// getConfigurationFiles and are applied in the order they were added,
+// after the local files, before env-var/default/required processing in
+// Load.
+type Source interface {
+	// Read fetches the current configuration and reports its format
+	// (e.g. "yaml", "json", "toml") so Load can pick the right Decoder.
+	Read(ctx context.Context) (data []byte, format string, err error)
+}
+
+// Watchable is an optional interface a Source can implement so that
+// WatchConfig also reloads when the source's backing data changes, not
+// just when local files change. The returned channel should receive a
+// value every time the source's data may have changed; WatchConfig
+// debounces these the same way it debounces filesystem events.
+type Watchable interface {
+	Watch(ctx context.Context) (<-chan struct{}, error)
+}
+
+// AddSource registers a remote Source to be read on every call to Load,
+// after the local files but before env-var/default/required processing.
+// It is safe to call concurrently with Load or WatchConfig.
+func (c *Configor) AddSource(s Source) {
+	c.sourcesMu.Lock()
+	defer c.sourcesMu.Unlock()
+	c.sources = append(c.sources, s)
+}
+
+// getSources returns a snapshot of the registered sources, safe to range
+// over without holding sourcesMu for the duration.
+func (c *Configor) getSources() []Source {
+	c.sourcesMu.RLock()
+	defer c.sourcesMu.RUnlock()
+	return append([]Source(nil), c.sources...)
+}
+
+func (c *Configor) loadSource(config interface{}, s Source) error {
+	data, format, err := s.Read(context.Background())
+	if err != nil {
+		return err
+	}
+
+	decoder, ok := getDecoder(formatExt(format))
+	if !ok {
+		return fmt.Errorf("configor: no decoder registered for source format %q", format)
+	}
+	return decoder.Decode(data, config, c.ErrorOnUnmatchedKeys)
+}
+
+func formatExt(format string) string {
+	format = strings.TrimPrefix(format, ".")
+	return "." + format
+}
+
+func isURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+// HTTPSource reads configuration from an HTTP(S) endpoint, so a service
+// can bootstrap with configor.Load(&cfg, "http://config/app.yaml") the
+// same way it would from a local file. The format is taken from the URL
+// path's extension unless Format is set explicitly.
+type HTTPSource struct {
+	URL    string
+	Format string
+	Client *http.Client
+
+	// PollInterval controls how often Watch re-fetches the endpoint to
+	// check for changes. Defaults to 30s.
+	PollInterval time.Duration
+}
+
+// NewHTTPSource returns an HTTPSource for the given URL, deriving its
+// format from the URL's file extension.
+func NewHTTPSource(rawURL string) *HTTPSource {
+	var format string
+	if u, err := url.Parse(rawURL); err == nil {
+		format = strings.TrimPrefix(path.Ext(u.Path), ".")
+	}
+	return &HTTPSource{URL: rawURL, Format: format}
+}
+
+func (s *HTTPSource) client() *http.Client {
+	if s.Client != nil {
+		return s.Client
+	}
+	return http.DefaultClient
+}
+
+func (s *HTTPSource) Read(ctx context.Context) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := s.client().Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, "", fmt.Errorf("configor: GET %v returned status %v", s.URL, resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	format := s.Format
+	if format == "" {
+		format = strings.TrimPrefix(path.Ext(req.URL.Path), ".")
+	}
+	return data, format, nil
+}
+
+// Watch polls the endpoint every PollInterval and signals on the returned
+// channel when the response body's checksum changes.
+func (s *HTTPSource) Watch(ctx context.Context) (<-chan struct{}, error) {
+	interval := s.PollInterval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	changes := make(chan struct{})
+	go func() {
+		defer close(changes)
+
+		var last [sha256.Size]byte
+		first := true
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				data, _, err := s.Read(ctx)
+				if err != nil {
+					continue
+				}
+				sum := sha256.Sum256(data)
+				if first {
+					last, first = sum, false
+					continue
+				}
+				if sum != last {
+					last = sum
+					select {
+					case changes <- struct{}{}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+	return changes, nil
+}
+
+// EtcdKV is the minimal client surface EtcdSource needs, so applications
+// can adapt whichever etcd client they already depend on (e.g.
+// go.etcd.io/etcd/client/v3) without configor forcing that dependency on
+// every user.
+type EtcdKV interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// EtcdSource reads a single key from etcd as the configuration payload.
+// It is an adapter, not an etcd client: it does no dialing or discovery
+// itself, and Client must be supplied by the caller, wrapping whichever
+// real etcd client (e.g. go.etcd.io/etcd/client/v3) they already use.
+// configor does not depend on an etcd client library.
+type EtcdSource struct {
+	Client EtcdKV
+	Key    string
+	Format string
+}
+
+func (s *EtcdSource) Read(ctx context.Context) ([]byte, string, error) {
+	data, err := s.Client.Get(ctx, s.Key)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, s.Format, nil
+}
+
+// ConsulKV is the minimal client surface ConsulSource needs, so
+// applications can adapt whichever Consul client they already depend on
+// (e.g. github.com/hashicorp/consul/api) without configor forcing that
+// dependency on every user.
+type ConsulKV interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// ConsulSource reads a single key from Consul's KV store as the
+// configuration payload. Like EtcdSource, it is an adapter, not a Consul
+// client: Client must be supplied by the caller, wrapping whichever real
+// Consul client (e.g. github.com/hashicorp/consul/api) they already use.
+// configor does not depend on a Consul client library.
+type ConsulSource struct {
+	Client ConsulKV
+	Key    string
+	Format string
+}
+
+func (s *ConsulSource) Read(ctx context.Context) ([]byte, string, error) {
+	data, err := s.Client.Get(ctx, s.Key)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, s.Format, nil
+}
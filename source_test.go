@@ -0,0 +1,82 @@
+package configor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type sourceTestConfig struct {
+	Name string
+	Port int
+}
+
+func TestLoad_HTTPSourceURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("name: remote\nport: 9090\n"))
+	}))
+	defer server.Close()
+
+	var cfg sourceTestConfig
+	if err := Load(&cfg, server.URL+"/app.yaml"); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Name != "remote" || cfg.Port != 9090 {
+		t.Fatalf("unexpected config loaded from HTTPSource: %+v", cfg)
+	}
+}
+
+// fakeEtcdKV is the kind of adapter a caller is expected to write around
+// their real etcd client to satisfy EtcdKV; EtcdSource itself never talks
+// to etcd directly.
+type fakeEtcdKV struct {
+	values map[string][]byte
+}
+
+func (f *fakeEtcdKV) Get(ctx context.Context, key string) ([]byte, error) {
+	return f.values[key], nil
+}
+
+// TestWatchConfig_URLOnlyArgument covers the case in this request's own
+// description, Load(&cfg, "http://config/app.yaml"): WatchConfig must be
+// able to watch a config loaded purely from a URL argument instead of
+// erroring out because fsnotify can't watch URLs and no Source was ever
+// registered for it.
+func TestWatchConfig_URLOnlyArgument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("name: remote\nport: 9090\n"))
+	}))
+	defer server.Close()
+
+	var cfg sourceTestConfig
+	c := New(nil)
+	if err := c.Load(&cfg, server.URL+"/app.yaml"); err != nil {
+		t.Fatal(err)
+	}
+
+	watcher, err := c.WatchConfig(&cfg, func(event ChangeEvent) {})
+	if err != nil {
+		t.Fatalf("expected WatchConfig to succeed for a URL-only Load, got: %v", err)
+	}
+	defer watcher.Close()
+}
+
+func TestLoad_AddSourceEtcdAdapter(t *testing.T) {
+	c := New(nil)
+	c.AddSource(&EtcdSource{
+		Client: &fakeEtcdKV{values: map[string][]byte{"/app/config": []byte("name: etcd\nport: 7070\n")}},
+		Key:    "/app/config",
+		Format: "yaml",
+	})
+
+	var cfg sourceTestConfig
+	if err := c.Load(&cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Name != "etcd" || cfg.Port != 7070 {
+		t.Fatalf("unexpected config loaded from EtcdSource: %+v", cfg)
+	}
+}
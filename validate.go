@@ -0,0 +1,254 @@
+package configor
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Violation is a single failed rule from a `validate` struct tag.
+type Violation struct {
+	Path    string
+	Rule    string
+	Value   interface{}
+	Message string
+}
+
+// ValidationError is returned by Load (and Validate) when one or more
+// `validate` rules fail. Unlike the `required` tag, which aborts Load at
+// the first missing value, all `validate` rules across the whole struct
+// are checked and collected into a single ValidationError.
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = fmt.Sprintf("%v: %v", v.Path, v.Message)
+	}
+	return fmt.Sprintf("configor: validation failed:\n%v", strings.Join(messages, "\n"))
+}
+
+// ValidatorFunc checks a single field's value against param, the text
+// following "=" in the rule (empty if the rule took no param). It should
+// return a descriptive error if the value is invalid.
+type ValidatorFunc func(v reflect.Value, param string) error
+
+// RegisterValidator registers a custom rule usable in `validate` struct
+// tags under name, e.g. RegisterValidator("duration", ...) enables
+// `validate:"duration"`. It overrides any built-in or previously
+// registered rule with the same name.
+func (c *Configor) RegisterValidator(name string, fn ValidatorFunc) {
+	c.validatorsMu.Lock()
+	defer c.validatorsMu.Unlock()
+
+	if c.validators == nil {
+		c.validators = map[string]ValidatorFunc{}
+	}
+	c.validators[name] = fn
+}
+
+func (c *Configor) getValidator(name string) (ValidatorFunc, bool) {
+	c.validatorsMu.RLock()
+	defer c.validatorsMu.RUnlock()
+	fn, ok := c.validators[name]
+	return fn, ok
+}
+
+// Validate walks config and checks every `validate` struct tag, returning
+// a *ValidationError listing every violation found, or nil if there were
+// none. Load calls Validate after processing tags, so most callers don't
+// need to call it directly.
+func (c *Configor) Validate(config interface{}) error {
+	var violations []Violation
+	if err := c.collectViolations(config, "", &violations); err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: violations}
+}
+
+func (c *Configor) collectViolations(config interface{}, fieldPath string, violations *[]Violation) error {
+	configValue := reflect.Indirect(reflect.ValueOf(config))
+	for configValue.Kind() == reflect.Ptr {
+		configValue = configValue.Elem()
+	}
+
+	if configValue.Kind() != reflect.Struct {
+		return fmt.Errorf("expected struct but got %v", configValue.Kind().String())
+	}
+
+	configType := configValue.Type()
+	for i := 0; i < configType.NumField(); i++ {
+		fieldStruct := configType.Field(i)
+		field := configValue.Field(i)
+
+		if !field.CanAddr() || !field.CanInterface() {
+			continue
+		}
+
+		currentPath := fieldStruct.Name
+		if fieldPath != "" {
+			currentPath = fieldPath + "." + currentPath
+		}
+
+		if tag := fieldStruct.Tag.Get("validate"); tag != "" {
+			for _, rule := range strings.Split(tag, ",") {
+				rule = strings.TrimSpace(rule)
+				if rule == "" {
+					continue
+				}
+				if err := c.checkRule(field, rule); err != nil {
+					*violations = append(*violations, Violation{
+						Path:    currentPath,
+						Rule:    rule,
+						Value:   safeInterface(field),
+						Message: err.Error(),
+					})
+				}
+			}
+		}
+
+		walkValue := field
+		for walkValue.Kind() == reflect.Ptr {
+			walkValue = walkValue.Elem()
+		}
+
+		if walkValue.Kind() == reflect.Struct {
+			if err := c.collectViolations(walkValue.Addr().Interface(), currentPath, violations); err != nil {
+				return err
+			}
+		}
+
+		if walkValue.Kind() == reflect.Slice {
+			for i := 0; i < walkValue.Len(); i++ {
+				if reflect.Indirect(walkValue.Index(i)).Kind() == reflect.Struct {
+					path := fmt.Sprintf("%v.%v", currentPath, i)
+					if err := c.collectViolations(walkValue.Index(i).Addr().Interface(), path, violations); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkRule evaluates a single rule, e.g. "required", "min=3" or
+// "oneof=a b c", against field.
+func (c *Configor) checkRule(field reflect.Value, rule string) error {
+	name, param, _ := strings.Cut(rule, "=")
+	name = strings.TrimSpace(name)
+	param = strings.TrimSpace(param)
+
+	if fn, ok := c.getValidator(name); ok {
+		return fn(field, param)
+	}
+
+	switch name {
+	case "required", "nonzero":
+		if reflect.DeepEqual(field.Interface(), reflect.Zero(field.Type()).Interface()) {
+			return fmt.Errorf("is required, but blank")
+		}
+		return nil
+
+	case "min":
+		return checkNumeric(field, param, func(v, n float64) bool { return v >= n }, "min", param)
+
+	case "max":
+		return checkNumeric(field, param, func(v, n float64) bool { return v <= n }, "max", param)
+
+	case "gte":
+		return checkNumeric(field, param, func(v, n float64) bool { return v >= n }, "gte", param)
+
+	case "lte":
+		return checkNumeric(field, param, func(v, n float64) bool { return v <= n }, "lte", param)
+
+	case "len":
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return fmt.Errorf("invalid len param %q", param)
+		}
+		if length(field) != n {
+			return fmt.Errorf("must have length %v", n)
+		}
+		return nil
+
+	case "oneof":
+		value := fmt.Sprint(field.Interface())
+		for _, option := range strings.Fields(param) {
+			if value == option {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of [%v]", param)
+
+	case "regexp":
+		re, err := regexp.Compile(param)
+		if err != nil {
+			return fmt.Errorf("invalid regexp %q: %v", param, err)
+		}
+		if !re.MatchString(fmt.Sprint(field.Interface())) {
+			return fmt.Errorf("must match %v", param)
+		}
+		return nil
+
+	case "url":
+		raw := fmt.Sprint(field.Interface())
+		if u, err := url.Parse(raw); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("must be a valid URL")
+		}
+		return nil
+
+	case "email":
+		if _, err := mail.ParseAddress(fmt.Sprint(field.Interface())); err != nil {
+			return fmt.Errorf("must be a valid email address")
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown validation rule %q", name)
+	}
+}
+
+func checkNumeric(field reflect.Value, param string, ok func(v, n float64) bool, rule, raw string) error {
+	n, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %v param %q", rule, param)
+	}
+
+	var v float64
+	switch {
+	case field.Kind() >= reflect.Int && field.Kind() <= reflect.Int64:
+		v = float64(field.Int())
+	case field.Kind() >= reflect.Uint && field.Kind() <= reflect.Uint64:
+		v = float64(field.Uint())
+	case field.Kind() == reflect.Float32 || field.Kind() == reflect.Float64:
+		v = field.Float()
+	case field.Kind() == reflect.String || field.Kind() == reflect.Slice || field.Kind() == reflect.Array:
+		v = float64(length(field))
+	default:
+		return fmt.Errorf("%v is not supported on %v", rule, field.Kind())
+	}
+
+	if !ok(v, n) {
+		return fmt.Errorf("must satisfy %v=%v", rule, raw)
+	}
+	return nil
+}
+
+func length(field reflect.Value) int {
+	switch field.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return field.Len()
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,53 @@
+package configor
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type validateTestConfig struct {
+	Name string `validate:"required,oneof=alice bob"`
+	Port int    `validate:"min=1024,max=65535"`
+}
+
+func TestValidate_CollectsAllViolations(t *testing.T) {
+	cfg := validateTestConfig{Name: "carol", Port: 80}
+
+	c := New(nil)
+	err := c.Validate(&cfg)
+	if err == nil {
+		t.Fatal("expected a validation error")
+	}
+
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	if len(verr.Violations) != 2 {
+		t.Fatalf("expected both the oneof and min violations to be collected, got %+v", verr.Violations)
+	}
+}
+
+func TestValidate_CustomValidator(t *testing.T) {
+	type durationConfig struct {
+		Timeout int `validate:"positive"`
+	}
+
+	c := New(nil)
+	c.RegisterValidator("positive", func(v reflect.Value, param string) error {
+		if v.Int() <= 0 {
+			return fmt.Errorf("must be positive")
+		}
+		return nil
+	})
+
+	if err := c.Validate(&durationConfig{Timeout: 5}); err != nil {
+		t.Fatalf("expected a positive timeout to pass, got %v", err)
+	}
+
+	err := c.Validate(&durationConfig{Timeout: -1})
+	if err == nil {
+		t.Fatal("expected a validation error for a non-positive timeout")
+	}
+}
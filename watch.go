@@ -0,0 +1,284 @@
+package configor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// FieldChange describes a single struct field whose value differed between
+// the previous and newly reloaded configuration.
+type FieldChange struct {
+	Path     string
+	OldValue interface{}
+	NewValue interface{}
+}
+
+// ChangeEvent is passed to the callback registered with WatchConfig
+// whenever a reload produces a config that differs from the one before it.
+type ChangeEvent struct {
+	Changes []FieldChange
+}
+
+// WatchConfig watches every file resolved by getConfigurationFiles for the
+// most recent call to Load (base, environment overlay and example
+// variants) using fsnotify, and reloads config whenever one of them is
+// created, written to or renamed. Filesystem events are debounced by
+// ~100ms so a burst of events from a single save only triggers one reload.
+// Any URL among those files, and any Source registered via AddSource, is
+// watched too, as long as it implements Watchable (HTTPSource does).
+//
+// Each reload re-runs Load in the same overlay order under a mutex,
+// decodes into a fresh copy of config, diffs it against the previous value
+// field by field via reflection, and, if anything changed, copies the new
+// field values onto config and invokes onChange with the list of changed
+// field paths and their old/new values. That in-place copy is only safe
+// for a single goroutine that owns config and isn't reading it while a
+// reload may be in flight; it is not synchronized with arbitrary
+// concurrent readers. For readers on other goroutines, call Config() on
+// the returned *Watcher instead of reading config's fields directly: it
+// returns the latest reloaded snapshot through an atomic.Value, and each
+// snapshot, once published, is never mutated again, so it can be read
+// without additional locking. Load must have been called on c with
+// config (or an equivalent value) before WatchConfig.
+func (c *Configor) WatchConfig(config interface{}, onChange func(event ChangeEvent)) (io.Closer, error) {
+	defaultValue := reflect.Indirect(reflect.ValueOf(config))
+	if !defaultValue.CanAddr() {
+		return nil, fmt.Errorf("Config %v should be addressable", config)
+	}
+
+	// A URL passed directly to Load (e.g. Load(&cfg, "http://config/app.yaml"))
+	// is read through an ad hoc HTTPSource rather than c.sources, so
+	// reconstruct an equivalent HTTPSource here for each one: fsnotify can't
+	// watch it, but HTTPSource implements Watchable, so it still drives
+	// reloads the same way a Source registered via AddSource would.
+	var localFiles []string
+	var adHocSources []Source
+	for _, file := range c.getConfigurationFiles(c.loadFiles...) {
+		if isURL(file) {
+			adHocSources = append(adHocSources, NewHTTPSource(file))
+		} else {
+			localFiles = append(localFiles, file)
+		}
+	}
+
+	sources := append(adHocSources, c.getSources()...)
+
+	if len(localFiles) == 0 && len(sources) == 0 {
+		return nil, fmt.Errorf("no configuration files or sources to watch, call Load first")
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range localFiles {
+		if err := watcher.Add(file); err != nil {
+			watcher.Close()
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &Watcher{
+		configor: c,
+		watcher:  watcher,
+		config:   config,
+		onChange: onChange,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+
+	initial := reflect.New(reflect.Indirect(reflect.ValueOf(config)).Type())
+	initial.Elem().Set(reflect.Indirect(reflect.ValueOf(config)))
+	w.current.Store(initial.Interface())
+
+	for _, source := range sources {
+		watchable, ok := source.(Watchable)
+		if !ok {
+			continue
+		}
+		changes, err := watchable.Watch(ctx)
+		if err != nil {
+			watcher.Close()
+			cancel()
+			return nil, err
+		}
+		go w.watchSource(changes)
+	}
+
+	go w.run()
+	return w, nil
+}
+
+const watchDebounce = 100 * time.Millisecond
+
+// Watcher is the io.Closer returned by WatchConfig. Use Config for
+// concurrency-safe reads of the latest reloaded configuration; see
+// WatchConfig's doc comment for why reading the original config value
+// directly from another goroutine is not safe.
+type Watcher struct {
+	configor *Configor
+	watcher  *fsnotify.Watcher
+	config   interface{}
+	onChange func(event ChangeEvent)
+	cancel   context.CancelFunc
+	current  atomic.Value
+
+	mu        sync.Mutex
+	timer     *time.Timer
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// Config returns the most recently reloaded configuration. The returned
+// value is a pointer to a struct that, once published here, is never
+// modified again, so it can be read from any goroutine without further
+// synchronization.
+func (w *Watcher) Config() interface{} {
+	return w.current.Load()
+}
+
+func (w *Watcher) run() {
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Rename) == 0 {
+				continue
+			}
+			w.scheduleReload()
+		case _, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// watchSource forwards change notifications from a Watchable Source's
+// channel into the same debounced reload path used for filesystem events.
+func (w *Watcher) watchSource(changes <-chan struct{}) {
+	for {
+		select {
+		case _, ok := <-changes:
+			if !ok {
+				return
+			}
+			w.scheduleReload()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// scheduleReload coalesces events that arrive within watchDebounce of each
+// other into a single reload.
+func (w *Watcher) scheduleReload() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+	w.timer = time.AfterFunc(watchDebounce, w.reload)
+}
+
+func (w *Watcher) reload() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	configValue := reflect.Indirect(reflect.ValueOf(w.config))
+
+	previous := reflect.New(configValue.Type())
+	previous.Elem().Set(configValue)
+
+	next := reflect.New(configValue.Type())
+	if err := w.configor.Load(next.Interface(), w.configor.loadFiles...); err != nil {
+		return
+	}
+
+	changes := diffStruct("", previous.Elem(), next.Elem())
+	if len(changes) == 0 {
+		return
+	}
+
+	// next is never touched again after this point, so publishing it here
+	// is what makes Config() safe to read from any goroutine without a
+	// lock. The in-place copy onto w.config below is a best-effort
+	// convenience for single-goroutine callers only; see the WatchConfig
+	// doc comment.
+	w.current.Store(next.Interface())
+	configValue.Set(next.Elem())
+
+	if w.onChange != nil {
+		w.onChange(ChangeEvent{Changes: changes})
+	}
+}
+
+// Close stops watching the configuration files and releases the
+// underlying fsnotify watcher.
+func (w *Watcher) Close() error {
+	var err error
+	w.closeOnce.Do(func() {
+		close(w.done)
+		if w.cancel != nil {
+			w.cancel()
+		}
+		err = w.watcher.Close()
+	})
+	return err
+}
+
+func diffStruct(path string, oldValue, newValue reflect.Value) []FieldChange {
+	var changes []FieldChange
+
+	for oldValue.Kind() == reflect.Ptr {
+		if oldValue.IsNil() != newValue.IsNil() {
+			changes = append(changes, FieldChange{Path: path, OldValue: safeInterface(oldValue), NewValue: safeInterface(newValue)})
+			return changes
+		}
+		if oldValue.IsNil() {
+			return changes
+		}
+		oldValue, newValue = oldValue.Elem(), newValue.Elem()
+	}
+
+	if oldValue.Kind() == reflect.Struct {
+		t := oldValue.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if !oldValue.Field(i).CanInterface() {
+				continue
+			}
+			fieldPath := t.Field(i).Name
+			if path != "" {
+				fieldPath = path + "." + fieldPath
+			}
+			changes = append(changes, diffStruct(fieldPath, oldValue.Field(i), newValue.Field(i))...)
+		}
+		return changes
+	}
+
+	if !reflect.DeepEqual(safeInterface(oldValue), safeInterface(newValue)) {
+		changes = append(changes, FieldChange{Path: path, OldValue: safeInterface(oldValue), NewValue: safeInterface(newValue)})
+	}
+	return changes
+}
+
+func safeInterface(v reflect.Value) interface{} {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil
+	}
+	return v.Interface()
+}
@@ -0,0 +1,95 @@
+package configor
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+type watchTestConfig struct {
+	Name string
+	Port int
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestWatchConfig_ReloadsOnFileChange exercises a full watch/edit/reload
+// cycle, including a goroutine that reads the watcher's safe snapshot
+// accessor concurrently with the reload, so it fails under -race if the
+// snapshot published by Config isn't actually safe to read lock-free.
+func TestWatchConfig_ReloadsOnFileChange(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "app.yaml")
+	writeFile(t, file, "name: first\nport: 1111\n")
+
+	var cfg watchTestConfig
+	c := New(nil)
+	if err := c.Load(&cfg, file); err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	var lastEvent ChangeEvent
+	received := make(chan struct{}, 1)
+
+	watcher, err := c.WatchConfig(&cfg, func(event ChangeEvent) {
+		mu.Lock()
+		lastEvent = event
+		mu.Unlock()
+		select {
+		case received <- struct{}{}:
+		default:
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				if snap := watcher.(*Watcher).Config(); snap != nil {
+					_ = snap.(*watchTestConfig).Name
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}()
+
+	writeFile(t, file, "name: second\nport: 2222\n")
+
+	select {
+	case <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reload")
+	}
+
+	close(stop)
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(lastEvent.Changes) == 0 {
+		t.Fatal("expected at least one field change")
+	}
+
+	snap := watcher.(*Watcher).Config().(*watchTestConfig)
+	if snap.Name != "second" || snap.Port != 2222 {
+		t.Fatalf("unexpected snapshot after reload: %+v", snap)
+	}
+}